@@ -0,0 +1,78 @@
+package graphs
+
+import "errors"
+
+var errOddCycleNotFound = errors.New("odd cycle not found")
+
+// IsBipartite reports whether the graph is bipartite by attempting a 2-colouring via BFS
+// from every unvisited vertex, assigning alternating colours 0/1. If it succeeds, it
+// returns true along with the colour assigned to every vertex. If a neighbour already
+// coloured the same as the current vertex is found, it returns false along with the
+// conflicting odd cycle.
+func (u *UnDirectedGraph) IsBipartite() (bool, []int, error) {
+	colors := make([]int, u.vertexCount)
+	for i := range colors {
+		colors[i] = -1
+	}
+
+	for start := 0; start < u.vertexCount; start++ {
+		if colors[start] != -1 {
+			continue
+		}
+
+		colors[start] = 0
+		parent := make([]int, u.vertexCount)
+		parent[start] = -1
+		queue := []int{start}
+
+		for len(queue) > 0 {
+			vertex := queue[0]
+			queue = queue[1:]
+
+			for _, v := range u.adjacentVertices[vertex] {
+				if colors[v] == -1 {
+					colors[v] = 1 - colors[vertex]
+					parent[v] = vertex
+					queue = append(queue, v)
+				} else if colors[v] == colors[vertex] {
+					cycle, err := oddCycle(parent, vertex, v)
+					if err != nil {
+						return false, nil, err
+					}
+					return false, cycle, nil
+				}
+			}
+		}
+	}
+
+	return true, colors, nil
+}
+
+// oddCycle reconstructs the odd cycle found when both endpoints of edge (u, v) ended up
+// with the same colour, by walking both vertices up to their common ancestor via parent.
+func oddCycle(parent []int, u, v int) ([]int, error) {
+	pathToU := []int{u}
+	for p := parent[u]; p != -1; p = parent[p] {
+		pathToU = append(pathToU, p)
+	}
+	pathToV := []int{v}
+	for p := parent[v]; p != -1; p = parent[p] {
+		pathToV = append(pathToV, p)
+	}
+
+	onPathToU := make(map[int]int, len(pathToU))
+	for i, vertex := range pathToU {
+		onPathToU[vertex] = i
+	}
+
+	for j, vertex := range pathToV {
+		if i, ok := onPathToU[vertex]; ok {
+			cycle := append([]int{}, pathToU[:i+1]...)
+			for k := j - 1; k >= 0; k-- {
+				cycle = append(cycle, pathToV[k])
+			}
+			return cycle, nil
+		}
+	}
+	return nil, errOddCycleNotFound
+}
@@ -0,0 +1,49 @@
+package graphs
+
+import "testing"
+
+func TestUnDirectedGraphIsBipartiteTrue(t *testing.T) {
+	// A 4-cycle is bipartite with colors alternating 0/1.
+	g := NewUnDirectedGraph(4)
+	edges := [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("AddEdge(%d, %d) returned error: %v", e[0], e[1], err)
+		}
+	}
+
+	ok, colors, err := g.IsBipartite()
+	if err != nil {
+		t.Fatalf("IsBipartite() returned error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("IsBipartite() = false, want true")
+	}
+	for _, e := range edges {
+		if colors[e[0]] == colors[e[1]] {
+			t.Errorf("IsBipartite() colors %v give edge %d-%d the same color", colors, e[0], e[1])
+		}
+	}
+}
+
+func TestUnDirectedGraphIsBipartiteFalse(t *testing.T) {
+	// A 3-cycle (triangle) is the canonical odd cycle and is not bipartite.
+	g := NewUnDirectedGraph(3)
+	edges := [][2]int{{0, 1}, {1, 2}, {2, 0}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("AddEdge(%d, %d) returned error: %v", e[0], e[1], err)
+		}
+	}
+
+	ok, cycle, err := g.IsBipartite()
+	if err != nil {
+		t.Fatalf("IsBipartite() returned error: %v", err)
+	}
+	if ok {
+		t.Fatalf("IsBipartite() = true for a triangle, want false")
+	}
+	if len(cycle)%2 == 0 {
+		t.Errorf("IsBipartite() conflicting cycle %v has even length, want odd", cycle)
+	}
+}
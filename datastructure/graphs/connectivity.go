@@ -0,0 +1,101 @@
+package graphs
+
+// ConnectedComponents returns the vertex groups of the graph, one slice per connected
+// component, found via repeated DFS.
+func (u *UnDirectedGraph) ConnectedComponents() [][]int {
+	visited := make([]bool, u.vertexCount)
+	var components [][]int
+
+	for vertex := 0; vertex < u.vertexCount; vertex++ {
+		if !visited[vertex] {
+			component := u.dfsRecursively(vertex, &visited)
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+// IsConnected reports whether the graph has at most a single connected component.
+func (u *UnDirectedGraph) IsConnected() bool {
+	return len(u.ConnectedComponents()) <= 1
+}
+
+// bridgesAndArticulationPoints performs a single Tarjan low-link DFS pass, computing
+// discovery times disc[v] and low-link values low[v], where low[v] is the lowest disc
+// reachable from the subtree rooted at v (including back-edges).
+func (u *UnDirectedGraph) bridgesAndArticulationPoints() (bridges [][2]int, articulationPoints []int) {
+	disc := make([]int, u.vertexCount)
+	low := make([]int, u.vertexCount)
+	visited := make([]bool, u.vertexCount)
+	isArticulation := make([]bool, u.vertexCount)
+	timer := 0
+
+	var visit func(vertex, parent int)
+	visit = func(vertex, parent int) {
+		visited[vertex] = true
+		disc[vertex] = timer
+		low[vertex] = timer
+		timer++
+
+		children := 0
+		skippedParent := false
+		for _, v := range u.adjacentVertices[vertex] {
+			if v == parent && !skippedParent {
+				skippedParent = true // only skip the first parallel edge back to the parent
+				continue
+			}
+			if !visited[v] {
+				children++
+				visit(v, vertex)
+				low[vertex] = min(low[vertex], low[v])
+
+				if low[v] > disc[vertex] {
+					bridges = append(bridges, [2]int{vertex, v})
+				}
+				if parent != -1 && low[v] >= disc[vertex] {
+					isArticulation[vertex] = true
+				}
+			} else {
+				low[vertex] = min(low[vertex], disc[v])
+			}
+		}
+
+		if parent == -1 && children >= 2 {
+			isArticulation[vertex] = true
+		}
+	}
+
+	for vertex := 0; vertex < u.vertexCount; vertex++ {
+		if !visited[vertex] {
+			visit(vertex, -1)
+		}
+	}
+
+	for vertex, is := range isArticulation {
+		if is {
+			articulationPoints = append(articulationPoints, vertex)
+		}
+	}
+	return bridges, articulationPoints
+}
+
+// Bridges returns every bridge (a.k.a. cut-edge) in the graph, found via Tarjan's
+// low-link algorithm in a single DFS pass.
+func (u *UnDirectedGraph) Bridges() [][2]int {
+	bridges, _ := u.bridgesAndArticulationPoints()
+	return bridges
+}
+
+// ArticulationPoints returns every articulation point (a.k.a. cut-vertex) in the graph,
+// found via Tarjan's low-link algorithm in a single DFS pass.
+func (u *UnDirectedGraph) ArticulationPoints() []int {
+	_, articulationPoints := u.bridgesAndArticulationPoints()
+	return articulationPoints
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+package graphs
+
+import "testing"
+
+func TestUnDirectedGraphConnectedComponents(t *testing.T) {
+	// {0,1,2} and {3,4} form two separate components.
+	g := NewUnDirectedGraph(5)
+	edges := [][2]int{{0, 1}, {1, 2}, {3, 4}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("AddEdge(%d, %d) returned error: %v", e[0], e[1], err)
+		}
+	}
+
+	components := g.ConnectedComponents()
+	if len(components) != 2 {
+		t.Fatalf("ConnectedComponents() = %v, want 2 components", components)
+	}
+	if g.IsConnected() {
+		t.Errorf("IsConnected() = true for a graph with 2 components")
+	}
+}
+
+func TestUnDirectedGraphIsConnected(t *testing.T) {
+	g := NewUnDirectedGraph(3)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	if !g.IsConnected() {
+		t.Errorf("IsConnected() = false for a fully connected graph")
+	}
+}
+
+func TestUnDirectedGraphBridgesAndArticulationPoints(t *testing.T) {
+	// 0-1-2 form a triangle; 2-3 is a bridge connecting the triangle to vertex 3.
+	g := NewUnDirectedGraph(4)
+	edges := [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("AddEdge(%d, %d) returned error: %v", e[0], e[1], err)
+		}
+	}
+
+	bridges := g.Bridges()
+	if len(bridges) != 1 || bridges[0] != [2]int{2, 3} {
+		t.Errorf("Bridges() = %v, want [[2 3]]", bridges)
+	}
+
+	articulationPoints := g.ArticulationPoints()
+	if !intSliceEqual(articulationPoints, []int{2}) {
+		t.Errorf("ArticulationPoints() = %v, want [2]", articulationPoints)
+	}
+}
@@ -0,0 +1,108 @@
+package graphs
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+)
+
+// dijkstraShortestPathTree runs Dijkstra's algorithm from src over adjacentVertices using
+// an indexed min-heap keyed by vertex id (so DecreaseKey is O(log n)), and returns, for
+// every vertex, its shortest distance from src and the predecessor on that shortest
+// path. Unreachable vertices have distance +Inf and predecessor -1. It is shared between
+// WeightedUnDirectedGraph and WeightedDirectedGraph, which differ only in whether
+// AddWeightedEdge populates both endpoints' adjacency lists.
+func dijkstraShortestPathTree(adjacentVertices [][]weightedEdge, vertexCount, src int) (dist []float64, prev []int) {
+	dist = make([]float64, vertexCount)
+	prev = make([]int, vertexCount)
+	for v := range dist {
+		dist[v] = math.Inf(1)
+		prev[v] = -1
+	}
+	dist[src] = 0
+
+	pq := &vertexHeap{}
+	inHeap := make([]*heapItem, vertexCount)
+
+	push := func(v int, d float64) {
+		item := &heapItem{vertex: v, dist: d}
+		inHeap[v] = item
+		heap.Push(pq, item)
+	}
+	decreaseKey := func(item *heapItem, d float64) {
+		item.dist = d
+		heap.Fix(pq, item.index)
+	}
+
+	push(src, 0)
+
+	for pq.Len() > 0 {
+		u := heap.Pop(pq).(*heapItem).vertex
+
+		for _, e := range adjacentVertices[u] {
+			v := e.to
+			alt := dist[u] + e.weight
+			if alt < dist[v] {
+				dist[v] = alt
+				prev[v] = u
+				if item := inHeap[v]; item != nil {
+					decreaseKey(item, alt)
+				} else {
+					push(v, alt)
+				}
+			}
+		}
+	}
+
+	return dist, prev
+}
+
+// dijkstraShortestPath reconstructs the shortest path and distance from src to dst by
+// running dijkstraShortestPathTree and walking prev back from dst. It returns an error
+// if dst is unreachable from src.
+func dijkstraShortestPath(adjacentVertices [][]weightedEdge, vertexCount, src, dst int) (path []int, dist float64, err error) {
+	distTo, prev := dijkstraShortestPathTree(adjacentVertices, vertexCount, src)
+
+	if math.IsInf(distTo[dst], 1) {
+		return nil, 0, errors.New("path not found")
+	}
+
+	for vertex := dst; vertex != -1; vertex = prev[vertex] {
+		path = append([]int{vertex}, path...)
+	}
+	return path, distTo[dst], nil
+}
+
+// heapItem is a single entry in the indexed min-heap used by Dijkstra's algorithm.
+type heapItem struct {
+	vertex int
+	dist   float64
+	index  int
+}
+
+// vertexHeap is an indexed min-heap of heapItems ordered by dist, supporting O(log n) DecreaseKey via heap.Fix.
+type vertexHeap []*heapItem
+
+func (h vertexHeap) Len() int { return len(h) }
+
+func (h vertexHeap) Less(i, j int) bool { return h[i].dist < h[j].dist }
+
+func (h vertexHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *vertexHeap) Push(x interface{}) {
+	item := x.(*heapItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *vertexHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,93 @@
+package graphs
+
+import "testing"
+
+func TestWeightedUnDirectedGraphShortestPath(t *testing.T) {
+	g := NewWeightedUnDirectedGraph(5)
+	edges := []struct {
+		v1, v2 int
+		weight float64
+	}{
+		{0, 1, 4},
+		{0, 2, 1},
+		{2, 1, 2},
+		{1, 3, 1},
+		{2, 3, 5},
+		{3, 4, 3},
+	}
+	for _, e := range edges {
+		if err := g.AddWeightedEdge(e.v1, e.v2, e.weight); err != nil {
+			t.Fatalf("AddWeightedEdge(%d, %d, %v) returned error: %v", e.v1, e.v2, e.weight, err)
+		}
+	}
+
+	path, dist, err := g.ShortestPath(0, 4)
+	if err != nil {
+		t.Fatalf("ShortestPath(0, 4) returned error: %v", err)
+	}
+	wantPath := []int{0, 2, 1, 3, 4}
+	if !intSliceEqual(path, wantPath) {
+		t.Errorf("ShortestPath(0, 4) path = %v, want %v", path, wantPath)
+	}
+	if wantDist := 7.0; dist != wantDist {
+		t.Errorf("ShortestPath(0, 4) dist = %v, want %v", dist, wantDist)
+	}
+}
+
+func TestWeightedUnDirectedGraphShortestPathUnreachable(t *testing.T) {
+	g := NewWeightedUnDirectedGraph(3)
+	if err := g.AddWeightedEdge(0, 1, 1); err != nil {
+		t.Fatalf("AddWeightedEdge returned error: %v", err)
+	}
+
+	if _, _, err := g.ShortestPath(0, 2); err == nil {
+		t.Errorf("ShortestPath(0, 2) expected an error for an unreachable vertex, got nil")
+	}
+}
+
+func TestWeightedDirectedGraphShortestPath(t *testing.T) {
+	g := NewWeightedDirectedGraph(4)
+	edges := []struct {
+		v1, v2 int
+		weight float64
+	}{
+		{0, 1, 1},
+		{1, 2, 2},
+		{0, 2, 5},
+		{2, 3, 1},
+	}
+	for _, e := range edges {
+		if err := g.AddWeightedEdge(e.v1, e.v2, e.weight); err != nil {
+			t.Fatalf("AddWeightedEdge(%d, %d, %v) returned error: %v", e.v1, e.v2, e.weight, err)
+		}
+	}
+
+	path, dist, err := g.ShortestPath(0, 3)
+	if err != nil {
+		t.Fatalf("ShortestPath(0, 3) returned error: %v", err)
+	}
+	wantPath := []int{0, 1, 2, 3}
+	if !intSliceEqual(path, wantPath) {
+		t.Errorf("ShortestPath(0, 3) path = %v, want %v", path, wantPath)
+	}
+	if wantDist := 4.0; dist != wantDist {
+		t.Errorf("ShortestPath(0, 3) dist = %v, want %v", dist, wantDist)
+	}
+
+	// Edges only run forward, so there is no directed path back to 0.
+	if _, _, err := g.ShortestPath(3, 0); err == nil {
+		t.Errorf("ShortestPath(3, 0) expected an error, got nil")
+	}
+}
+
+func intSliceEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
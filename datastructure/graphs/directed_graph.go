@@ -0,0 +1,204 @@
+package graphs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCycle is returned when a graph operation that requires acyclicity (such as
+// TopologicalSort) encounters a cycle. Edge holds the back-edge that closed the cycle.
+type ErrCycle struct {
+	Edge [2]int
+}
+
+func (e *ErrCycle) Error() string {
+	return fmt.Sprintf("cycle detected at edge %d -> %d", e.Edge[0], e.Edge[1])
+}
+
+// vertexColor is used by the three-colour DFS in TopologicalSort and cycle detection.
+type vertexColor int
+
+const (
+	white vertexColor = iota
+	gray
+	black
+)
+
+// DirectedGraph defines a directed graph
+type DirectedGraph struct {
+	vertexCount      int
+	edgeCount        int
+	adjacentVertices [][]int
+}
+
+// NewDirectedGraph initalises a new directed graph with vertexCount vertices.
+func NewDirectedGraph(vertexCount int) *DirectedGraph {
+	return &DirectedGraph{
+		vertexCount, 0, make([][]int, vertexCount),
+	}
+}
+
+func (d *DirectedGraph) isVertexValid(vertex int) bool {
+	return vertex >= 0 && vertex < d.vertexCount
+}
+
+// GetVertexCount gets vertex count
+func (d *DirectedGraph) GetVertexCount() int {
+	return d.vertexCount
+}
+
+// GetEdgeCount gets the edge count
+func (d *DirectedGraph) GetEdgeCount() int {
+	return d.edgeCount
+}
+
+// AddEdge adds a directed edge from vertex "from" to vertex "to"
+func (d *DirectedGraph) AddEdge(from, to int) error {
+	if d.isVertexValid(from) && d.isVertexValid(to) {
+		d.adjacentVertices[from] = append(d.adjacentVertices[from], to)
+		d.edgeCount++
+		return nil
+	}
+	return errors.New("vertex not found")
+}
+
+// GetAdjacentVertices gets all vertices reachable via an outgoing edge from a given vertex
+func (d *DirectedGraph) GetAdjacentVertices(vertex int) ([]int, error) {
+	if d.isVertexValid(vertex) {
+		return d.adjacentVertices[vertex], nil
+	}
+	return nil, errors.New("vertex not found")
+}
+
+// OutDegree gets the number of outgoing edges of a given vertex
+func (d *DirectedGraph) OutDegree(vertex int) (int, error) {
+	if !d.isVertexValid(vertex) {
+		return 0, errors.New("vertex not found")
+	}
+	return len(d.adjacentVertices[vertex]), nil
+}
+
+// InDegree gets the number of incoming edges of a given vertex
+func (d *DirectedGraph) InDegree(vertex int) (int, error) {
+	if !d.isVertexValid(vertex) {
+		return 0, errors.New("vertex not found")
+	}
+	degree := 0
+	for _, adjs := range d.adjacentVertices {
+		for _, v := range adjs {
+			if v == vertex {
+				degree++
+			}
+		}
+	}
+	return degree, nil
+}
+
+// Reverse returns a new DirectedGraph with every edge reversed.
+func (d *DirectedGraph) Reverse() *DirectedGraph {
+	reversed := NewDirectedGraph(d.vertexCount)
+	for from, adjs := range d.adjacentVertices {
+		for _, to := range adjs {
+			// AddEdge only fails for out-of-range vertices, which cannot happen here.
+			_ = reversed.AddEdge(to, from)
+		}
+	}
+	return reversed
+}
+
+// Print prints the graph.
+func (d *DirectedGraph) Print() string {
+	res := ""
+	res += fmt.Sprintf("Vertex Count: %d, Edge Count: %d\n", d.vertexCount, d.edgeCount)
+	for vertex, adjacentVertices := range d.adjacentVertices {
+		res += fmt.Sprintf("Vertex %d: %v\n", vertex, adjacentVertices)
+	}
+	return res
+}
+
+// TopologicalSort returns a topological ordering of the graph's vertices using the
+// DFS-with-three-colours approach. It returns an ErrCycle if the graph is not a DAG.
+func (d *DirectedGraph) TopologicalSort() ([]int, error) {
+	colors := make([]vertexColor, d.vertexCount)
+	stack := make([]int, 0, d.vertexCount)
+
+	var visit func(vertex int) error
+	visit = func(vertex int) error {
+		colors[vertex] = gray
+		for _, v := range d.adjacentVertices[vertex] {
+			switch colors[v] {
+			case gray:
+				return &ErrCycle{Edge: [2]int{vertex, v}}
+			case white:
+				if err := visit(v); err != nil {
+					return err
+				}
+			}
+		}
+		colors[vertex] = black
+		stack = append(stack, vertex)
+		return nil
+	}
+
+	for vertex := 0; vertex < d.vertexCount; vertex++ {
+		if colors[vertex] == white {
+			if err := visit(vertex); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order := make([]int, d.vertexCount)
+	for i, v := range stack {
+		order[d.vertexCount-1-i] = v
+	}
+	return order, nil
+}
+
+// HasCycle reports whether the graph contains at least one cycle.
+func (d *DirectedGraph) HasCycle() bool {
+	_, err := d.TopologicalSort()
+	return err != nil
+}
+
+// FindCycle returns one concrete cycle in the graph for diagnostics, or an error if the
+// graph is acyclic.
+func (d *DirectedGraph) FindCycle() ([]int, error) {
+	colors := make([]vertexColor, d.vertexCount)
+	onStack := make([]int, 0, d.vertexCount)
+	posOnStack := make([]int, d.vertexCount)
+
+	var cycle []int
+	var visit func(vertex int) bool
+	visit = func(vertex int) bool {
+		colors[vertex] = gray
+		posOnStack[vertex] = len(onStack)
+		onStack = append(onStack, vertex)
+
+		for _, v := range d.adjacentVertices[vertex] {
+			switch colors[v] {
+			case gray:
+				cycle = append([]int{}, onStack[posOnStack[v]:]...)
+				cycle = append(cycle, v)
+				return true
+			case white:
+				if visit(v) {
+					return true
+				}
+			}
+		}
+
+		colors[vertex] = black
+		onStack = onStack[:len(onStack)-1]
+		return false
+	}
+
+	for vertex := 0; vertex < d.vertexCount; vertex++ {
+		if colors[vertex] == white {
+			if visit(vertex) {
+				return cycle, nil
+			}
+		}
+	}
+	return nil, errors.New("no cycle found")
+}
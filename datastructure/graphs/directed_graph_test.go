@@ -0,0 +1,89 @@
+package graphs
+
+import "testing"
+
+func TestDirectedGraphTopologicalSort(t *testing.T) {
+	// 0 -> 1 -> 3
+	// 0 -> 2 -> 3
+	g := NewDirectedGraph(4)
+	edges := [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("AddEdge(%d, %d) returned error: %v", e[0], e[1], err)
+		}
+	}
+
+	order, err := g.TopologicalSort()
+	if err != nil {
+		t.Fatalf("TopologicalSort() returned error: %v", err)
+	}
+
+	position := make(map[int]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+	for _, e := range edges {
+		if position[e[0]] >= position[e[1]] {
+			t.Errorf("TopologicalSort() order %v violates edge %d -> %d", order, e[0], e[1])
+		}
+	}
+
+	if g.HasCycle() {
+		t.Errorf("HasCycle() = true for an acyclic graph")
+	}
+}
+
+func TestDirectedGraphCycleDetection(t *testing.T) {
+	g := NewDirectedGraph(3)
+	edges := [][2]int{{0, 1}, {1, 2}, {2, 0}}
+	for _, e := range edges {
+		if err := g.AddEdge(e[0], e[1]); err != nil {
+			t.Fatalf("AddEdge(%d, %d) returned error: %v", e[0], e[1], err)
+		}
+	}
+
+	if !g.HasCycle() {
+		t.Errorf("HasCycle() = false for a cyclic graph")
+	}
+
+	if _, err := g.TopologicalSort(); err == nil {
+		t.Errorf("TopologicalSort() expected an error for a cyclic graph, got nil")
+	} else if _, ok := err.(*ErrCycle); !ok {
+		t.Errorf("TopologicalSort() error type = %T, want *ErrCycle", err)
+	}
+
+	cycle, err := g.FindCycle()
+	if err != nil {
+		t.Fatalf("FindCycle() returned error: %v", err)
+	}
+	// FindCycle returns the cycle vertices plus a repeated closing vertex.
+	if len(cycle) != 4 || cycle[0] != cycle[len(cycle)-1] {
+		t.Errorf("FindCycle() = %v, want a closed cycle of length 4", cycle)
+	}
+}
+
+func TestDirectedGraphReverse(t *testing.T) {
+	g := NewDirectedGraph(3)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	reversed := g.Reverse()
+	adjs, err := reversed.GetAdjacentVertices(2)
+	if err != nil {
+		t.Fatalf("GetAdjacentVertices(2) returned error: %v", err)
+	}
+	if !intSliceEqual(adjs, []int{1}) {
+		t.Errorf("Reverse() adjacency of 2 = %v, want [1]", adjs)
+	}
+
+	if out, _ := reversed.OutDegree(0); out != 0 {
+		t.Errorf("Reverse() OutDegree(0) = %d, want 0", out)
+	}
+	if in, _ := reversed.InDegree(0); in != 1 {
+		t.Errorf("Reverse() InDegree(0) = %d, want 1", in)
+	}
+}
@@ -0,0 +1,108 @@
+package graphs
+
+// GenericDirectedGraph is a generic façade over DirectedGraph, mirroring Graph[T] but
+// for directed edges and DirectedGraph's DAG-oriented API (TopologicalSort, HasCycle,
+// FindCycle) instead of BFS/DFS/Path.
+type GenericDirectedGraph[T comparable] struct {
+	vertexIndex[T]
+	underlying *DirectedGraph
+}
+
+// NewGenericDirectedGraph initalises a new empty generic directed graph.
+func NewGenericDirectedGraph[T comparable]() *GenericDirectedGraph[T] {
+	return &GenericDirectedGraph[T]{
+		vertexIndex: newVertexIndex[T](),
+		underlying:  NewDirectedGraph(0),
+	}
+}
+
+// AddVertex adds a new vertex identified by id, growing the underlying adjacency slices.
+// It returns an error if id has already been added.
+func (g *GenericDirectedGraph[T]) AddVertex(id T) error {
+	_, err := g.add(id)
+	if err != nil {
+		return err
+	}
+	g.underlying.adjacentVertices = append(g.underlying.adjacentVertices, nil)
+	g.underlying.vertexCount++
+	return nil
+}
+
+// GetVertexCount gets vertex count
+func (g *GenericDirectedGraph[T]) GetVertexCount() int {
+	return g.underlying.GetVertexCount()
+}
+
+// GetEdgeCount gets the edge count
+func (g *GenericDirectedGraph[T]) GetEdgeCount() int {
+	return g.underlying.GetEdgeCount()
+}
+
+// AddEdge adds a directed edge from "from" to "to", both of which must already exist.
+func (g *GenericDirectedGraph[T]) AddEdge(from, to T) error {
+	fromIndex, err := g.index(from)
+	if err != nil {
+		return err
+	}
+	toIndex, err := g.index(to)
+	if err != nil {
+		return err
+	}
+	return g.underlying.AddEdge(fromIndex, toIndex)
+}
+
+// AdjacentVertices gets all vertices reachable via an outgoing edge from a given vertex
+func (g *GenericDirectedGraph[T]) AdjacentVertices(v T) ([]T, error) {
+	index, err := g.index(v)
+	if err != nil {
+		return nil, err
+	}
+	adjs, err := g.underlying.GetAdjacentVertices(index)
+	if err != nil {
+		return nil, err
+	}
+	return g.translate(adjs), nil
+}
+
+// InDegree gets the number of incoming edges of a given vertex
+func (g *GenericDirectedGraph[T]) InDegree(v T) (int, error) {
+	index, err := g.index(v)
+	if err != nil {
+		return 0, err
+	}
+	return g.underlying.InDegree(index)
+}
+
+// OutDegree gets the number of outgoing edges of a given vertex
+func (g *GenericDirectedGraph[T]) OutDegree(v T) (int, error) {
+	index, err := g.index(v)
+	if err != nil {
+		return 0, err
+	}
+	return g.underlying.OutDegree(index)
+}
+
+// TopologicalSort returns a topological ordering of the graph's vertices. It returns an
+// ErrCycle if the graph is not a DAG.
+func (g *GenericDirectedGraph[T]) TopologicalSort() ([]T, error) {
+	order, err := g.underlying.TopologicalSort()
+	if err != nil {
+		return nil, err
+	}
+	return g.translate(order), nil
+}
+
+// HasCycle reports whether the graph contains at least one cycle.
+func (g *GenericDirectedGraph[T]) HasCycle() bool {
+	return g.underlying.HasCycle()
+}
+
+// FindCycle returns one concrete cycle in the graph for diagnostics, or an error if the
+// graph is acyclic.
+func (g *GenericDirectedGraph[T]) FindCycle() ([]T, error) {
+	cycle, err := g.underlying.FindCycle()
+	if err != nil {
+		return nil, err
+	}
+	return g.translate(cycle), nil
+}
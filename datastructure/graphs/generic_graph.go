@@ -0,0 +1,109 @@
+package graphs
+
+// Graph is a generic façade over UnDirectedGraph that lets callers identify vertices by
+// an arbitrary comparable value (strings, UUIDs, structs, ...) instead of a dense
+// 0..N-1 integer index. It translates ids to indices and delegates to the underlying
+// UnDirectedGraph for the actual graph algorithms.
+type Graph[T comparable] struct {
+	vertexIndex[T]
+	underlying *UnDirectedGraph
+}
+
+// NewGraph initalises a new empty generic graph.
+func NewGraph[T comparable]() *Graph[T] {
+	return &Graph[T]{
+		vertexIndex: newVertexIndex[T](),
+		underlying:  NewUnDirectedGraph(0),
+	}
+}
+
+// AddVertex adds a new vertex identified by id, growing the underlying adjacency slices.
+// It returns an error if id has already been added.
+func (g *Graph[T]) AddVertex(id T) error {
+	_, err := g.add(id)
+	if err != nil {
+		return err
+	}
+	g.underlying.adjacentVertices = append(g.underlying.adjacentVertices, nil)
+	g.underlying.vertexCount++
+	return nil
+}
+
+// GetVertexCount gets vertex count
+func (g *Graph[T]) GetVertexCount() int {
+	return g.underlying.GetVertexCount()
+}
+
+// GetEdgeCount gets the edge count
+func (g *Graph[T]) GetEdgeCount() int {
+	return g.underlying.GetEdgeCount()
+}
+
+// AddEdge adds an edge between vertices a and b, both of which must already exist.
+func (g *Graph[T]) AddEdge(a, b T) error {
+	ai, err := g.index(a)
+	if err != nil {
+		return err
+	}
+	bi, err := g.index(b)
+	if err != nil {
+		return err
+	}
+	return g.underlying.AddEdge(ai, bi)
+}
+
+// AdjacentVertices gets all adjacent vertices for a given vertex
+func (g *Graph[T]) AdjacentVertices(v T) ([]T, error) {
+	index, err := g.index(v)
+	if err != nil {
+		return nil, err
+	}
+	adjs, err := g.underlying.GetAdjacentVertices(index)
+	if err != nil {
+		return nil, err
+	}
+	return g.translate(adjs), nil
+}
+
+// BFS does a breadth first search starting from startingVertex in graph
+func (g *Graph[T]) BFS(startingVertex T) ([]T, error) {
+	index, err := g.index(startingVertex)
+	if err != nil {
+		return nil, err
+	}
+	vertices, err := g.underlying.BFS(index)
+	if err != nil {
+		return nil, err
+	}
+	return g.translate(vertices), nil
+}
+
+// DFS does a depth first search
+func (g *Graph[T]) DFS(startingVertex T) ([]T, error) {
+	index, err := g.index(startingVertex)
+	if err != nil {
+		return nil, err
+	}
+	vertices, err := g.underlying.DFS(index)
+	if err != nil {
+		return nil, err
+	}
+	return g.translate(vertices), nil
+}
+
+// Path gets the shortest (minimum number of edges) path from a to b.
+func (g *Graph[T]) Path(a, b T) ([]T, error) {
+	ai, err := g.index(a)
+	if err != nil {
+		return nil, err
+	}
+	bi, err := g.index(b)
+	if err != nil {
+		return nil, err
+	}
+	path, err := g.underlying.GetBFSPath(ai, bi)
+	if err != nil {
+		return nil, err
+	}
+	return g.translate(path), nil
+}
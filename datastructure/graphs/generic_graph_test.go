@@ -0,0 +1,103 @@
+package graphs
+
+import "testing"
+
+func TestGraphBasics(t *testing.T) {
+	g := NewGraph[string]()
+	for _, id := range []string{"a", "b", "c"} {
+		if err := g.AddVertex(id); err != nil {
+			t.Fatalf("AddVertex(%q) returned error: %v", id, err)
+		}
+	}
+	if err := g.AddEdge("a", "b"); err != nil {
+		t.Fatalf("AddEdge(a, b) returned error: %v", err)
+	}
+	if err := g.AddEdge("b", "c"); err != nil {
+		t.Fatalf("AddEdge(b, c) returned error: %v", err)
+	}
+
+	path, err := g.Path("a", "c")
+	if err != nil {
+		t.Fatalf("Path(a, c) returned error: %v", err)
+	}
+	want := []string{"a", "b", "c"}
+	if len(path) != len(want) {
+		t.Fatalf("Path(a, c) = %v, want %v", path, want)
+	}
+	for i := range want {
+		if path[i] != want[i] {
+			t.Errorf("Path(a, c) = %v, want %v", path, want)
+		}
+	}
+
+	if err := g.AddVertex("a"); err == nil {
+		t.Errorf("AddVertex(a) expected an error for a duplicate id, got nil")
+	}
+	if _, err := g.Path("a", "nope"); err == nil {
+		t.Errorf("Path(a, nope) expected an error for an unknown id, got nil")
+	}
+}
+
+func TestGenericDirectedGraphTopologicalSort(t *testing.T) {
+	g := NewGenericDirectedGraph[string]()
+	for _, id := range []string{"build", "test", "deploy"} {
+		if err := g.AddVertex(id); err != nil {
+			t.Fatalf("AddVertex(%q) returned error: %v", id, err)
+		}
+	}
+	if err := g.AddEdge("build", "test"); err != nil {
+		t.Fatalf("AddEdge(build, test) returned error: %v", err)
+	}
+	if err := g.AddEdge("test", "deploy"); err != nil {
+		t.Fatalf("AddEdge(test, deploy) returned error: %v", err)
+	}
+
+	order, err := g.TopologicalSort()
+	if err != nil {
+		t.Fatalf("TopologicalSort() returned error: %v", err)
+	}
+	position := make(map[string]int, len(order))
+	for i, id := range order {
+		position[id] = i
+	}
+	if position["build"] >= position["test"] || position["test"] >= position["deploy"] {
+		t.Errorf("TopologicalSort() = %v, violates build -> test -> deploy", order)
+	}
+
+	if g.HasCycle() {
+		t.Errorf("HasCycle() = true for an acyclic graph")
+	}
+}
+
+func TestGenericDirectedGraphCycleDetection(t *testing.T) {
+	g := NewGenericDirectedGraph[string]()
+	for _, id := range []string{"a", "b", "c"} {
+		if err := g.AddVertex(id); err != nil {
+			t.Fatalf("AddVertex(%q) returned error: %v", id, err)
+		}
+	}
+	if err := g.AddEdge("a", "b"); err != nil {
+		t.Fatalf("AddEdge(a, b) returned error: %v", err)
+	}
+	if err := g.AddEdge("b", "c"); err != nil {
+		t.Fatalf("AddEdge(b, c) returned error: %v", err)
+	}
+	if err := g.AddEdge("c", "a"); err != nil {
+		t.Fatalf("AddEdge(c, a) returned error: %v", err)
+	}
+
+	if !g.HasCycle() {
+		t.Errorf("HasCycle() = false for a cyclic graph")
+	}
+	if _, err := g.TopologicalSort(); err == nil {
+		t.Errorf("TopologicalSort() expected an error for a cyclic graph, got nil")
+	}
+
+	cycle, err := g.FindCycle()
+	if err != nil {
+		t.Fatalf("FindCycle() returned error: %v", err)
+	}
+	if len(cycle) != 4 || cycle[0] != cycle[len(cycle)-1] {
+		t.Errorf("FindCycle() = %v, want a closed cycle of length 4", cycle)
+	}
+}
@@ -0,0 +1,10 @@
+package io
+
+// isCanonicalEdge reports whether (from, to) is the canonical direction in which to
+// emit an undirected edge. Every undirected edge is stored in both endpoints'
+// adjacency lists; emitting it only when to >= from avoids emitting it twice. Self-loops
+// are not covered: both of AddEdge(v, v)'s two entries land on v and both pass this
+// check, so callers pair them up themselves.
+func isCanonicalEdge(from, to int) bool {
+	return to >= from
+}
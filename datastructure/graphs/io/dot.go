@@ -0,0 +1,225 @@
+// Package io provides serialization helpers for the graphs package: Graphviz DOT
+// output, and plain-text edge lists. (JSON (un)marshaling lives on the graph types
+// themselves, in the graphs package, as json.Marshaler/json.Unmarshaler.)
+package io
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/screeningeagle/go-common-utils/datastructure/graphs"
+)
+
+// DOTOptions configures WriteDOT/WriteDirectedDOT's output.
+type DOTOptions struct {
+	// Name is the graph name emitted after the "graph"/"digraph" keyword. Defaults to "G".
+	Name string
+	// VertexLabel, if set, is called for every vertex to produce its node label.
+	VertexLabel func(vertex int) string
+	// EdgeAttrs, if set, is called for every edge to produce its attribute string,
+	// e.g. `label="3"`. Returning "" omits the attribute block.
+	EdgeAttrs func(v1, v2 int) string
+}
+
+// WeightedDOTOptions configures WriteWeightedDOT/WriteWeightedDirectedDOT's output.
+type WeightedDOTOptions struct {
+	// Name is the graph name emitted after the "graph"/"digraph" keyword. Defaults to "G".
+	Name string
+	// VertexLabel, if set, is called for every vertex to produce its node label.
+	VertexLabel func(vertex int) string
+	// EdgeAttrs, if set, is called for every edge to produce its attribute string. If
+	// unset, edges are labelled with their weight.
+	EdgeAttrs func(v1, v2 int, weight float64) string
+}
+
+// dotEdge is an edge ready to be rendered, independent of which graph type produced it.
+type dotEdge struct {
+	from, to int
+	attrs    string
+}
+
+// WriteDOT writes g to w in Graphviz DOT format. Every vertex gets a node statement, so
+// isolated vertices are preserved in the rendered graph.
+func WriteDOT(w io.Writer, g *graphs.UnDirectedGraph, opts DOTOptions) error {
+	edges, err := undirectedDOTEdges(g, opts.EdgeAttrs)
+	if err != nil {
+		return err
+	}
+	return writeDOT(w, g.GetVertexCount(), false, opts.Name, opts.VertexLabel, edges)
+}
+
+// WriteDirectedDOT writes g to w in Graphviz DOT format, as a digraph.
+func WriteDirectedDOT(w io.Writer, g *graphs.DirectedGraph, opts DOTOptions) error {
+	edges, err := directedDOTEdges(g, opts.EdgeAttrs)
+	if err != nil {
+		return err
+	}
+	return writeDOT(w, g.GetVertexCount(), true, opts.Name, opts.VertexLabel, edges)
+}
+
+// WriteWeightedDOT writes g to w in Graphviz DOT format, labelling edges with their
+// weight unless opts.EdgeAttrs overrides it.
+func WriteWeightedDOT(w io.Writer, g *graphs.WeightedUnDirectedGraph, opts WeightedDOTOptions) error {
+	edges, err := weightedUndirectedDOTEdges(g, opts.EdgeAttrs)
+	if err != nil {
+		return err
+	}
+	return writeDOT(w, g.GetVertexCount(), false, opts.Name, opts.VertexLabel, edges)
+}
+
+// WriteWeightedDirectedDOT writes g to w in Graphviz DOT format, as a digraph,
+// labelling edges with their weight unless opts.EdgeAttrs overrides it.
+func WriteWeightedDirectedDOT(w io.Writer, g *graphs.WeightedDirectedGraph, opts WeightedDOTOptions) error {
+	edges, err := weightedDirectedDOTEdges(g, opts.EdgeAttrs)
+	if err != nil {
+		return err
+	}
+	return writeDOT(w, g.GetVertexCount(), true, opts.Name, opts.VertexLabel, edges)
+}
+
+func undirectedDOTEdges(g *graphs.UnDirectedGraph, edgeAttrs func(v1, v2 int) string) ([]dotEdge, error) {
+	var edges []dotEdge
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return nil, err
+		}
+		selfLoops := 0
+		for _, v := range adjs {
+			if v == vertex {
+				// AddEdge(v, v) stores a self-loop as two entries in adjacentVertices[v],
+				// so only the first entry of every pair is emitted.
+				selfLoops++
+				if selfLoops%2 == 0 {
+					continue
+				}
+			} else if !isCanonicalEdge(vertex, v) {
+				continue
+			}
+			attrs := ""
+			if edgeAttrs != nil {
+				attrs = edgeAttrs(vertex, v)
+			}
+			edges = append(edges, dotEdge{vertex, v, attrs})
+		}
+	}
+	return edges, nil
+}
+
+func directedDOTEdges(g *graphs.DirectedGraph, edgeAttrs func(v1, v2 int) string) ([]dotEdge, error) {
+	var edges []dotEdge
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range adjs {
+			attrs := ""
+			if edgeAttrs != nil {
+				attrs = edgeAttrs(vertex, v)
+			}
+			edges = append(edges, dotEdge{vertex, v, attrs})
+		}
+	}
+	return edges, nil
+}
+
+func weightedUndirectedDOTEdges(g *graphs.WeightedUnDirectedGraph, edgeAttrs func(v1, v2 int, weight float64) string) ([]dotEdge, error) {
+	var edges []dotEdge
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return nil, err
+		}
+		selfLoops := 0
+		for _, v := range adjs {
+			if v == vertex {
+				// AddWeightedEdge(v, v, w) stores a self-loop as two entries in
+				// adjacentVertices[v], so only the first entry of every pair is emitted.
+				selfLoops++
+				if selfLoops%2 == 0 {
+					continue
+				}
+			} else if !isCanonicalEdge(vertex, v) {
+				continue
+			}
+			weight, err := g.GetEdgeWeight(vertex, v)
+			if err != nil {
+				return nil, err
+			}
+			edges = append(edges, dotEdge{vertex, v, weightedEdgeAttrs(edgeAttrs, vertex, v, weight)})
+		}
+	}
+	return edges, nil
+}
+
+func weightedDirectedDOTEdges(g *graphs.WeightedDirectedGraph, edgeAttrs func(v1, v2 int, weight float64) string) ([]dotEdge, error) {
+	var edges []dotEdge
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range adjs {
+			weight, err := g.GetEdgeWeight(vertex, v)
+			if err != nil {
+				return nil, err
+			}
+			edges = append(edges, dotEdge{vertex, v, weightedEdgeAttrs(edgeAttrs, vertex, v, weight)})
+		}
+	}
+	return edges, nil
+}
+
+func weightedEdgeAttrs(edgeAttrs func(v1, v2 int, weight float64) string, v1, v2 int, weight float64) string {
+	if edgeAttrs != nil {
+		return edgeAttrs(v1, v2, weight)
+	}
+	return fmt.Sprintf("label=%q", fmt.Sprint(weight))
+}
+
+// writeDOT renders the DOT document shared by every WriteDOT* variant: a node
+// statement per vertex (so isolated vertices are never dropped), followed by one edge
+// statement per entry in edges.
+func writeDOT(w io.Writer, vertexCount int, directed bool, name string, vertexLabel func(int) string, edges []dotEdge) error {
+	keyword, connector := "graph", "--"
+	if directed {
+		keyword, connector = "digraph", "->"
+	}
+	if name == "" {
+		name = "G"
+	}
+
+	if _, err := fmt.Fprintf(w, "%s %s {\n", keyword, name); err != nil {
+		return err
+	}
+
+	for vertex := 0; vertex < vertexCount; vertex++ {
+		label := ""
+		if vertexLabel != nil {
+			label = vertexLabel(vertex)
+		}
+		if label == "" {
+			if _, err := fmt.Fprintf(w, "  %d;\n", vertex); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "  %d [label=%q];\n", vertex, label); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range edges {
+		attrs := ""
+		if e.attrs != "" {
+			attrs = fmt.Sprintf(" [%s]", e.attrs)
+		}
+		if _, err := fmt.Fprintf(w, "  %d %s %d%s;\n", e.from, connector, e.to, attrs); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
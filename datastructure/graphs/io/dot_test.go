@@ -0,0 +1,68 @@
+package io
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/screeningeagle/go-common-utils/datastructure/graphs"
+)
+
+func TestWriteDOTIncludesIsolatedVertices(t *testing.T) {
+	// Vertices 3 and 4 are isolated and must still be mentioned in the DOT output.
+	g := graphs.NewUnDirectedGraph(5)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDOT(&buf, g, DOTOptions{}); err != nil {
+		t.Fatalf("WriteDOT returned error: %v", err)
+	}
+
+	out := buf.String()
+	for _, vertex := range []int{0, 1, 2, 3, 4} {
+		if !bytes.Contains(buf.Bytes(), []byte(strconv.Itoa(vertex))) {
+			t.Errorf("WriteDOT() output missing vertex %d:\n%s", vertex, out)
+		}
+	}
+}
+
+func TestWriteDirectedDOT(t *testing.T) {
+	g := graphs.NewDirectedGraph(2)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDirectedDOT(&buf, g, DOTOptions{}); err != nil {
+		t.Fatalf("WriteDirectedDOT returned error: %v", err)
+	}
+
+	out := buf.String()
+	if !bytes.Contains(buf.Bytes(), []byte("digraph")) {
+		t.Errorf("WriteDirectedDOT() output missing 'digraph' keyword:\n%s", out)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("0 -> 1")) {
+		t.Errorf("WriteDirectedDOT() output missing directed edge:\n%s", out)
+	}
+}
+
+func TestWriteWeightedDOTDefaultLabel(t *testing.T) {
+	g := graphs.NewWeightedUnDirectedGraph(2)
+	if err := g.AddWeightedEdge(0, 1, 4.5); err != nil {
+		t.Fatalf("AddWeightedEdge returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteWeightedDOT(&buf, g, WeightedDOTOptions{}); err != nil {
+		t.Fatalf("WriteWeightedDOT returned error: %v", err)
+	}
+
+	if !bytes.Contains(buf.Bytes(), []byte(`label="4.5"`)) {
+		t.Errorf("WriteWeightedDOT() output missing weight label:\n%s", buf.String())
+	}
+}
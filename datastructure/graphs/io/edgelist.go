@@ -0,0 +1,284 @@
+package io
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/screeningeagle/go-common-utils/datastructure/graphs"
+)
+
+// Edge lists start with a header line holding the vertex count, so that isolated
+// vertices with no incident edge still round-trip correctly, followed by one "u v" (or
+// "u v w" for weighted graphs) line per edge.
+
+// WriteEdgeList writes g to w as a vertex-count header followed by plain-text "u v"
+// lines, one per edge.
+func WriteEdgeList(w io.Writer, g *graphs.UnDirectedGraph) error {
+	if _, err := fmt.Fprintf(w, "%d\n", g.GetVertexCount()); err != nil {
+		return err
+	}
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return err
+		}
+		selfLoops := 0
+		for _, v := range adjs {
+			if v == vertex {
+				selfLoops++
+				continue
+			}
+			if !isCanonicalEdge(vertex, v) {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "%d %d\n", vertex, v); err != nil {
+				return err
+			}
+		}
+		// AddEdge(v, v) stores a self-loop as two entries in adjacentVertices[v], so
+		// every pair of entries here is a single self-loop edge.
+		for i := 0; i < selfLoops/2; i++ {
+			if _, err := fmt.Fprintf(w, "%d %d\n", vertex, vertex); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadEdgeList reads a vertex-count header and "u v" lines from r, as written by
+// WriteEdgeList, and builds the corresponding UnDirectedGraph.
+func ReadEdgeList(r io.Reader) (*graphs.UnDirectedGraph, error) {
+	vertexCount, lines, err := readEdgeListHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := graphs.NewUnDirectedGraph(vertexCount)
+	for _, fields := range lines {
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid edge list line: %q", strings.Join(fields, " "))
+		}
+		v1, v2, err := parseEdgeVertices(fields)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.AddEdge(v1, v2); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// WriteDirectedEdgeList writes g to w as a vertex-count header followed by plain-text
+// "u v" lines, one per directed edge.
+func WriteDirectedEdgeList(w io.Writer, g *graphs.DirectedGraph) error {
+	if _, err := fmt.Fprintf(w, "%d\n", g.GetVertexCount()); err != nil {
+		return err
+	}
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return err
+		}
+		for _, v := range adjs {
+			if _, err := fmt.Fprintf(w, "%d %d\n", vertex, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadDirectedEdgeList reads a vertex-count header and "u v" lines from r, as written
+// by WriteDirectedEdgeList, and builds the corresponding DirectedGraph.
+func ReadDirectedEdgeList(r io.Reader) (*graphs.DirectedGraph, error) {
+	vertexCount, lines, err := readEdgeListHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := graphs.NewDirectedGraph(vertexCount)
+	for _, fields := range lines {
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid edge list line: %q", strings.Join(fields, " "))
+		}
+		from, to, err := parseEdgeVertices(fields)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.AddEdge(from, to); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// WriteWeightedEdgeList writes g to w as a vertex-count header followed by plain-text
+// "u v w" lines, one per edge.
+func WriteWeightedEdgeList(w io.Writer, g *graphs.WeightedUnDirectedGraph) error {
+	if _, err := fmt.Fprintf(w, "%d\n", g.GetVertexCount()); err != nil {
+		return err
+	}
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return err
+		}
+		selfLoops := 0
+		for _, v := range adjs {
+			if v == vertex {
+				selfLoops++
+				continue
+			}
+			if !isCanonicalEdge(vertex, v) {
+				continue
+			}
+			weight, err := g.GetEdgeWeight(vertex, v)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "%d %d %g\n", vertex, v, weight); err != nil {
+				return err
+			}
+		}
+		// AddWeightedEdge(v, v, w) stores a self-loop as two entries in
+		// adjacentVertices[v], so every pair of entries here is a single self-loop edge.
+		if selfLoops > 0 {
+			weight, err := g.GetEdgeWeight(vertex, vertex)
+			if err != nil {
+				return err
+			}
+			for i := 0; i < selfLoops/2; i++ {
+				if _, err := fmt.Fprintf(w, "%d %d %g\n", vertex, vertex, weight); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ReadWeightedEdgeList reads a vertex-count header and "u v w" lines from r, as written
+// by WriteWeightedEdgeList, and builds the corresponding WeightedUnDirectedGraph.
+func ReadWeightedEdgeList(r io.Reader) (*graphs.WeightedUnDirectedGraph, error) {
+	vertexCount, lines, err := readEdgeListHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := graphs.NewWeightedUnDirectedGraph(vertexCount)
+	for _, fields := range lines {
+		v1, v2, weight, err := parseWeightedEdge(fields)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.AddWeightedEdge(v1, v2, weight); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// WriteWeightedDirectedEdgeList writes g to w as a vertex-count header followed by
+// plain-text "u v w" lines, one per directed edge.
+func WriteWeightedDirectedEdgeList(w io.Writer, g *graphs.WeightedDirectedGraph) error {
+	if _, err := fmt.Fprintf(w, "%d\n", g.GetVertexCount()); err != nil {
+		return err
+	}
+	for vertex := 0; vertex < g.GetVertexCount(); vertex++ {
+		adjs, err := g.GetAdjacentVertices(vertex)
+		if err != nil {
+			return err
+		}
+		for _, v := range adjs {
+			weight, err := g.GetEdgeWeight(vertex, v)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(w, "%d %d %g\n", vertex, v, weight); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ReadWeightedDirectedEdgeList reads a vertex-count header and "u v w" lines from r, as
+// written by WriteWeightedDirectedEdgeList, and builds the corresponding
+// WeightedDirectedGraph.
+func ReadWeightedDirectedEdgeList(r io.Reader) (*graphs.WeightedDirectedGraph, error) {
+	vertexCount, lines, err := readEdgeListHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := graphs.NewWeightedDirectedGraph(vertexCount)
+	for _, fields := range lines {
+		from, to, weight, err := parseWeightedEdge(fields)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.AddWeightedEdge(from, to, weight); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// readEdgeListHeader reads the vertex-count header line, then every remaining
+// non-blank line split into whitespace-separated fields.
+func readEdgeListHeader(r io.Reader) (vertexCount int, lines [][]string, err error) {
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return 0, nil, err
+		}
+		return 0, nil, fmt.Errorf("missing vertex count header")
+	}
+	vertexCount, err = strconv.Atoi(strings.TrimSpace(scanner.Text()))
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid vertex count header: %w", err)
+	}
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		lines = append(lines, fields)
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, nil, err
+	}
+	return vertexCount, lines, nil
+}
+
+func parseEdgeVertices(fields []string) (v1, v2 int, err error) {
+	if v1, err = strconv.Atoi(fields[0]); err != nil {
+		return 0, 0, err
+	}
+	if v2, err = strconv.Atoi(fields[1]); err != nil {
+		return 0, 0, err
+	}
+	return v1, v2, nil
+}
+
+func parseWeightedEdge(fields []string) (v1, v2 int, weight float64, err error) {
+	if len(fields) != 3 {
+		return 0, 0, 0, fmt.Errorf("invalid edge list line: %q", strings.Join(fields, " "))
+	}
+	if v1, err = strconv.Atoi(fields[0]); err != nil {
+		return 0, 0, 0, err
+	}
+	if v2, err = strconv.Atoi(fields[1]); err != nil {
+		return 0, 0, 0, err
+	}
+	if weight, err = strconv.ParseFloat(fields[2], 64); err != nil {
+		return 0, 0, 0, err
+	}
+	return v1, v2, weight, nil
+}
@@ -0,0 +1,143 @@
+package io
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/screeningeagle/go-common-utils/datastructure/graphs"
+)
+
+func TestEdgeListRoundTripPreservesIsolatedVertices(t *testing.T) {
+	// Vertices 3 and 4 are isolated and must survive the round trip.
+	g := graphs.NewUnDirectedGraph(5)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteEdgeList(&buf, g); err != nil {
+		t.Fatalf("WriteEdgeList returned error: %v", err)
+	}
+
+	got, err := ReadEdgeList(&buf)
+	if err != nil {
+		t.Fatalf("ReadEdgeList returned error: %v", err)
+	}
+
+	if got.GetVertexCount() != g.GetVertexCount() {
+		t.Errorf("ReadEdgeList() vertex count = %d, want %d", got.GetVertexCount(), g.GetVertexCount())
+	}
+	if got.GetEdgeCount() != g.GetEdgeCount() {
+		t.Errorf("ReadEdgeList() edge count = %d, want %d", got.GetEdgeCount(), g.GetEdgeCount())
+	}
+}
+
+func TestEdgeListRoundTripSelfLoop(t *testing.T) {
+	g := graphs.NewUnDirectedGraph(3)
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(0, 0); err != nil {
+		t.Fatalf("AddEdge(0, 0) returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteEdgeList(&buf, g); err != nil {
+		t.Fatalf("WriteEdgeList returned error: %v", err)
+	}
+
+	got, err := ReadEdgeList(&buf)
+	if err != nil {
+		t.Fatalf("ReadEdgeList returned error: %v", err)
+	}
+
+	if got.GetEdgeCount() != g.GetEdgeCount() {
+		t.Errorf("ReadEdgeList() edge count = %d, want %d (self-loop must not be doubled)", got.GetEdgeCount(), g.GetEdgeCount())
+	}
+}
+
+func TestDirectedEdgeListRoundTrip(t *testing.T) {
+	g := graphs.NewDirectedGraph(4)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteDirectedEdgeList(&buf, g); err != nil {
+		t.Fatalf("WriteDirectedEdgeList returned error: %v", err)
+	}
+
+	got, err := ReadDirectedEdgeList(&buf)
+	if err != nil {
+		t.Fatalf("ReadDirectedEdgeList returned error: %v", err)
+	}
+
+	if got.GetVertexCount() != g.GetVertexCount() {
+		t.Errorf("ReadDirectedEdgeList() vertex count = %d, want %d", got.GetVertexCount(), g.GetVertexCount())
+	}
+	if out, _ := got.OutDegree(3); out != 0 {
+		t.Errorf("ReadDirectedEdgeList() lost the isolated vertex 3, OutDegree = %d", out)
+	}
+}
+
+func TestWeightedEdgeListRoundTrip(t *testing.T) {
+	g := graphs.NewWeightedUnDirectedGraph(3)
+	if err := g.AddWeightedEdge(0, 1, 2.5); err != nil {
+		t.Fatalf("AddWeightedEdge returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteWeightedEdgeList(&buf, g); err != nil {
+		t.Fatalf("WriteWeightedEdgeList returned error: %v", err)
+	}
+
+	got, err := ReadWeightedEdgeList(&buf)
+	if err != nil {
+		t.Fatalf("ReadWeightedEdgeList returned error: %v", err)
+	}
+
+	weight, err := got.GetEdgeWeight(0, 1)
+	if err != nil {
+		t.Fatalf("GetEdgeWeight(0, 1) returned error: %v", err)
+	}
+	if weight != 2.5 {
+		t.Errorf("GetEdgeWeight(0, 1) = %v, want 2.5", weight)
+	}
+}
+
+func TestWeightedEdgeListRoundTripSelfLoop(t *testing.T) {
+	g := graphs.NewWeightedUnDirectedGraph(3)
+	if err := g.AddWeightedEdge(1, 2, 1.5); err != nil {
+		t.Fatalf("AddWeightedEdge returned error: %v", err)
+	}
+	if err := g.AddWeightedEdge(0, 0, 5.0); err != nil {
+		t.Fatalf("AddWeightedEdge(0, 0, 5.0) returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteWeightedEdgeList(&buf, g); err != nil {
+		t.Fatalf("WriteWeightedEdgeList returned error: %v", err)
+	}
+
+	got, err := ReadWeightedEdgeList(&buf)
+	if err != nil {
+		t.Fatalf("ReadWeightedEdgeList returned error: %v", err)
+	}
+
+	if got.GetEdgeCount() != g.GetEdgeCount() {
+		t.Errorf("ReadWeightedEdgeList() edge count = %d, want %d (self-loop must not be doubled)", got.GetEdgeCount(), g.GetEdgeCount())
+	}
+	weight, err := got.GetEdgeWeight(0, 0)
+	if err != nil {
+		t.Fatalf("GetEdgeWeight(0, 0) returned error: %v", err)
+	}
+	if weight != 5.0 {
+		t.Errorf("GetEdgeWeight(0, 0) = %v, want 5.0", weight)
+	}
+}
@@ -0,0 +1,182 @@
+package graphs
+
+import "encoding/json"
+
+// isCanonicalUndirectedEdge reports whether (from, to) is the canonical direction in
+// which to emit a non-self-loop undirected edge. Every undirected edge is stored in
+// both endpoints' adjacency lists; emitting it only when to >= from avoids emitting it
+// twice. Self-loops need their own handling: both of AddEdge(v, v)'s two adjacency-list
+// entries land on the same vertex, so this canonical check can't distinguish them.
+func isCanonicalUndirectedEdge(from, to int) bool {
+	return to >= from
+}
+
+// jsonAdjacencyGraph is the JSON schema shared by UnDirectedGraph and DirectedGraph: a
+// vertex count plus, for every vertex, the list of vertices it is adjacent to.
+type jsonAdjacencyGraph struct {
+	VertexCount int     `json:"vertexCount"`
+	Adjacency   [][]int `json:"adjacency"`
+}
+
+// MarshalJSON encodes u as adjacency-list JSON. Round-tripping through UnmarshalJSON
+// preserves vertex count and edge multiplicity.
+func (u *UnDirectedGraph) MarshalJSON() ([]byte, error) {
+	adjacency := make([][]int, u.vertexCount)
+	for vertex, adjs := range u.adjacentVertices {
+		adjacency[vertex] = append([]int{}, adjs...)
+	}
+	return json.Marshal(jsonAdjacencyGraph{VertexCount: u.vertexCount, Adjacency: adjacency})
+}
+
+// UnmarshalJSON decodes adjacency-list JSON produced by MarshalJSON into u.
+func (u *UnDirectedGraph) UnmarshalJSON(data []byte) error {
+	var parsed jsonAdjacencyGraph
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+
+	g := NewUnDirectedGraph(parsed.VertexCount)
+	for vertex, adjs := range parsed.Adjacency {
+		selfLoops := 0
+		for _, v := range adjs {
+			if v == vertex {
+				selfLoops++
+				continue
+			}
+			if !isCanonicalUndirectedEdge(vertex, v) {
+				continue
+			}
+			if err := g.AddEdge(vertex, v); err != nil {
+				return err
+			}
+		}
+		// AddEdge(v, v) stores a self-loop as two entries in adjacentVertices[v], so
+		// every pair of entries here is a single self-loop edge.
+		for i := 0; i < selfLoops/2; i++ {
+			if err := g.AddEdge(vertex, vertex); err != nil {
+				return err
+			}
+		}
+	}
+	*u = *g
+	return nil
+}
+
+// MarshalJSON encodes d as adjacency-list JSON. Round-tripping through UnmarshalJSON
+// preserves vertex count and edge multiplicity.
+func (d *DirectedGraph) MarshalJSON() ([]byte, error) {
+	adjacency := make([][]int, d.vertexCount)
+	for vertex, adjs := range d.adjacentVertices {
+		adjacency[vertex] = append([]int{}, adjs...)
+	}
+	return json.Marshal(jsonAdjacencyGraph{VertexCount: d.vertexCount, Adjacency: adjacency})
+}
+
+// UnmarshalJSON decodes adjacency-list JSON produced by MarshalJSON into d.
+func (d *DirectedGraph) UnmarshalJSON(data []byte) error {
+	var parsed jsonAdjacencyGraph
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+
+	g := NewDirectedGraph(parsed.VertexCount)
+	for vertex, adjs := range parsed.Adjacency {
+		for _, v := range adjs {
+			if err := g.AddEdge(vertex, v); err != nil {
+				return err
+			}
+		}
+	}
+	*d = *g
+	return nil
+}
+
+// jsonWeightedEdge is the JSON representation of a single weighted adjacency entry.
+type jsonWeightedEdge struct {
+	To     int     `json:"to"`
+	Weight float64 `json:"weight"`
+}
+
+// jsonWeightedAdjacencyGraph is the JSON schema shared by WeightedUnDirectedGraph and
+// WeightedDirectedGraph.
+type jsonWeightedAdjacencyGraph struct {
+	VertexCount int                  `json:"vertexCount"`
+	Adjacency   [][]jsonWeightedEdge `json:"adjacency"`
+}
+
+// MarshalJSON encodes w as adjacency-list JSON. Round-tripping through UnmarshalJSON
+// preserves vertex count and edge multiplicity.
+func (w *WeightedUnDirectedGraph) MarshalJSON() ([]byte, error) {
+	adjacency := make([][]jsonWeightedEdge, w.vertexCount)
+	for vertex, adjs := range w.adjacentVertices {
+		for _, e := range adjs {
+			adjacency[vertex] = append(adjacency[vertex], jsonWeightedEdge{To: e.to, Weight: e.weight})
+		}
+	}
+	return json.Marshal(jsonWeightedAdjacencyGraph{VertexCount: w.vertexCount, Adjacency: adjacency})
+}
+
+// UnmarshalJSON decodes adjacency-list JSON produced by MarshalJSON into w.
+func (w *WeightedUnDirectedGraph) UnmarshalJSON(data []byte) error {
+	var parsed jsonWeightedAdjacencyGraph
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+
+	g := NewWeightedUnDirectedGraph(parsed.VertexCount)
+	for vertex, adjs := range parsed.Adjacency {
+		var selfLoopWeights []float64
+		for _, e := range adjs {
+			if e.To == vertex {
+				selfLoopWeights = append(selfLoopWeights, e.Weight)
+				continue
+			}
+			if !isCanonicalUndirectedEdge(vertex, e.To) {
+				continue
+			}
+			if err := g.AddWeightedEdge(vertex, e.To, e.Weight); err != nil {
+				return err
+			}
+		}
+		// AddWeightedEdge(v, v, w) stores a self-loop as two entries in
+		// adjacentVertices[v], so every pair of entries here is a single self-loop edge.
+		for i := 0; i+1 < len(selfLoopWeights); i += 2 {
+			if err := g.AddWeightedEdge(vertex, vertex, selfLoopWeights[i]); err != nil {
+				return err
+			}
+		}
+	}
+	*w = *g
+	return nil
+}
+
+// MarshalJSON encodes w as adjacency-list JSON. Round-tripping through UnmarshalJSON
+// preserves vertex count and edge multiplicity.
+func (w *WeightedDirectedGraph) MarshalJSON() ([]byte, error) {
+	adjacency := make([][]jsonWeightedEdge, w.vertexCount)
+	for vertex, adjs := range w.adjacentVertices {
+		for _, e := range adjs {
+			adjacency[vertex] = append(adjacency[vertex], jsonWeightedEdge{To: e.to, Weight: e.weight})
+		}
+	}
+	return json.Marshal(jsonWeightedAdjacencyGraph{VertexCount: w.vertexCount, Adjacency: adjacency})
+}
+
+// UnmarshalJSON decodes adjacency-list JSON produced by MarshalJSON into w.
+func (w *WeightedDirectedGraph) UnmarshalJSON(data []byte) error {
+	var parsed jsonWeightedAdjacencyGraph
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+
+	g := NewWeightedDirectedGraph(parsed.VertexCount)
+	for vertex, adjs := range parsed.Adjacency {
+		for _, e := range adjs {
+			if err := g.AddWeightedEdge(vertex, e.To, e.Weight); err != nil {
+				return err
+			}
+		}
+	}
+	*w = *g
+	return nil
+}
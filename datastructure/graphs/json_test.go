@@ -0,0 +1,138 @@
+package graphs
+
+import "testing"
+
+func TestUnDirectedGraphJSONRoundTrip(t *testing.T) {
+	// Vertices 3 and 4 are isolated and must survive the round trip.
+	g := NewUnDirectedGraph(5)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned error: %v", err)
+	}
+
+	var got UnDirectedGraph
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON() returned error: %v", err)
+	}
+
+	if got.GetVertexCount() != g.GetVertexCount() {
+		t.Errorf("UnmarshalJSON() vertex count = %d, want %d", got.GetVertexCount(), g.GetVertexCount())
+	}
+	if got.GetEdgeCount() != g.GetEdgeCount() {
+		t.Errorf("UnmarshalJSON() edge count = %d, want %d", got.GetEdgeCount(), g.GetEdgeCount())
+	}
+}
+
+func TestUnDirectedGraphJSONRoundTripSelfLoop(t *testing.T) {
+	g := NewUnDirectedGraph(3)
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(0, 0); err != nil {
+		t.Fatalf("AddEdge(0, 0) returned error: %v", err)
+	}
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned error: %v", err)
+	}
+
+	var got UnDirectedGraph
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON() returned error: %v", err)
+	}
+
+	if got.GetEdgeCount() != g.GetEdgeCount() {
+		t.Errorf("UnmarshalJSON() edge count = %d, want %d (self-loop must not be doubled)", got.GetEdgeCount(), g.GetEdgeCount())
+	}
+}
+
+func TestDirectedGraphJSONRoundTrip(t *testing.T) {
+	g := NewDirectedGraph(4)
+	if err := g.AddEdge(0, 1); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+	if err := g.AddEdge(1, 2); err != nil {
+		t.Fatalf("AddEdge returned error: %v", err)
+	}
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned error: %v", err)
+	}
+
+	var got DirectedGraph
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON() returned error: %v", err)
+	}
+
+	if got.GetVertexCount() != g.GetVertexCount() {
+		t.Errorf("UnmarshalJSON() vertex count = %d, want %d", got.GetVertexCount(), g.GetVertexCount())
+	}
+	if out, _ := got.OutDegree(3); out != 0 {
+		t.Errorf("UnmarshalJSON() lost the isolated vertex 3, OutDegree = %d", out)
+	}
+}
+
+func TestWeightedUnDirectedGraphJSONRoundTrip(t *testing.T) {
+	g := NewWeightedUnDirectedGraph(3)
+	if err := g.AddWeightedEdge(0, 1, 2.5); err != nil {
+		t.Fatalf("AddWeightedEdge returned error: %v", err)
+	}
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned error: %v", err)
+	}
+
+	var got WeightedUnDirectedGraph
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON() returned error: %v", err)
+	}
+
+	weight, err := got.GetEdgeWeight(0, 1)
+	if err != nil {
+		t.Fatalf("GetEdgeWeight(0, 1) returned error: %v", err)
+	}
+	if weight != 2.5 {
+		t.Errorf("GetEdgeWeight(0, 1) = %v, want 2.5", weight)
+	}
+}
+
+func TestWeightedUnDirectedGraphJSONRoundTripSelfLoop(t *testing.T) {
+	g := NewWeightedUnDirectedGraph(3)
+	if err := g.AddWeightedEdge(1, 2, 1.5); err != nil {
+		t.Fatalf("AddWeightedEdge returned error: %v", err)
+	}
+	if err := g.AddWeightedEdge(0, 0, 5.0); err != nil {
+		t.Fatalf("AddWeightedEdge(0, 0, 5.0) returned error: %v", err)
+	}
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned error: %v", err)
+	}
+
+	var got WeightedUnDirectedGraph
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON() returned error: %v", err)
+	}
+
+	if got.GetEdgeCount() != g.GetEdgeCount() {
+		t.Errorf("UnmarshalJSON() edge count = %d, want %d (self-loop must not be doubled)", got.GetEdgeCount(), g.GetEdgeCount())
+	}
+	weight, err := got.GetEdgeWeight(0, 0)
+	if err != nil {
+		t.Fatalf("GetEdgeWeight(0, 0) returned error: %v", err)
+	}
+	if weight != 5.0 {
+		t.Errorf("GetEdgeWeight(0, 0) = %v, want 5.0", weight)
+	}
+}
@@ -0,0 +1,45 @@
+package graphs
+
+import "errors"
+
+// vertexIndex maintains the id<->index translation shared by every generic graph
+// façade (Graph[T], GenericDirectedGraph[T]), so that translating ids to the dense
+// 0..N-1 indices used internally isn't duplicated across them.
+type vertexIndex[T comparable] struct {
+	idToIndex map[T]int
+	indexToID []T
+}
+
+func newVertexIndex[T comparable]() vertexIndex[T] {
+	return vertexIndex[T]{idToIndex: make(map[T]int)}
+}
+
+// add registers a new vertex id and returns its index. It returns an error if id has
+// already been added.
+func (vi *vertexIndex[T]) add(id T) (int, error) {
+	if _, exists := vi.idToIndex[id]; exists {
+		return 0, errors.New("vertex already exists")
+	}
+	index := len(vi.indexToID)
+	vi.idToIndex[id] = index
+	vi.indexToID = append(vi.indexToID, id)
+	return index, nil
+}
+
+// index looks up the index of an already-added vertex id.
+func (vi *vertexIndex[T]) index(id T) (int, error) {
+	index, exists := vi.idToIndex[id]
+	if !exists {
+		return 0, errors.New("vertex not found")
+	}
+	return index, nil
+}
+
+// translate converts a slice of internal indices back to their vertex ids.
+func (vi *vertexIndex[T]) translate(indices []int) []T {
+	ids := make([]T, len(indices))
+	for i, index := range indices {
+		ids[i] = vi.indexToID[index]
+	}
+	return ids
+}
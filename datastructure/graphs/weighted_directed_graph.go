@@ -0,0 +1,95 @@
+package graphs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WeightedDirectedGraph defines a directed graph with weighted edges
+type WeightedDirectedGraph struct {
+	vertexCount      int
+	edgeCount        int
+	adjacentVertices [][]weightedEdge
+}
+
+// NewWeightedDirectedGraph initalises a new weighted directed graph with vertexCount vertices.
+func NewWeightedDirectedGraph(vertexCount int) *WeightedDirectedGraph {
+	return &WeightedDirectedGraph{
+		vertexCount, 0, make([][]weightedEdge, vertexCount),
+	}
+}
+
+func (w *WeightedDirectedGraph) isVertexValid(vertex int) bool {
+	return vertex >= 0 && vertex < w.vertexCount
+}
+
+// GetVertexCount gets vertex count
+func (w *WeightedDirectedGraph) GetVertexCount() int {
+	return w.vertexCount
+}
+
+// GetEdgeCount gets the edge count
+func (w *WeightedDirectedGraph) GetEdgeCount() int {
+	return w.edgeCount
+}
+
+// AddWeightedEdge adds a weighted edge from vertex1 to vertex2
+func (w *WeightedDirectedGraph) AddWeightedEdge(vertex1, vertex2 int, weight float64) error {
+	if w.isVertexValid(vertex1) && w.isVertexValid(vertex2) {
+		w.adjacentVertices[vertex1] = append(w.adjacentVertices[vertex1], weightedEdge{vertex2, weight})
+		w.edgeCount++
+		return nil
+	}
+	return errors.New("vertex not found")
+}
+
+// GetAdjacentVertices gets all adjacent vertices reachable from a given vertex
+func (w *WeightedDirectedGraph) GetAdjacentVertices(vertex int) ([]int, error) {
+	if !w.isVertexValid(vertex) {
+		return nil, errors.New("vertex not found")
+	}
+	vertices := make([]int, len(w.adjacentVertices[vertex]))
+	for i, e := range w.adjacentVertices[vertex] {
+		vertices[i] = e.to
+	}
+	return vertices, nil
+}
+
+// GetEdgeWeight gets the weight of the edge from vertex1 to vertex2.
+func (w *WeightedDirectedGraph) GetEdgeWeight(vertex1, vertex2 int) (float64, error) {
+	if !w.isVertexValid(vertex1) || !w.isVertexValid(vertex2) {
+		return 0, errors.New("vertex not found")
+	}
+	for _, e := range w.adjacentVertices[vertex1] {
+		if e.to == vertex2 {
+			return e.weight, nil
+		}
+	}
+	return 0, errors.New("edge not found")
+}
+
+// Print prints the graph.
+func (w *WeightedDirectedGraph) Print() string {
+	res := ""
+	res += fmt.Sprintf("Vertex Count: %d, Edge Count: %d\n", w.vertexCount, w.edgeCount)
+	for vertex, adjacentVertices := range w.adjacentVertices {
+		res += fmt.Sprintf("Vertex %d: %v\n", vertex, adjacentVertices)
+	}
+	return res
+}
+
+// ShortestPath returns the shortest path and distance from src to dst using Dijkstra's algorithm.
+// It returns an error if dst is unreachable from src.
+func (w *WeightedDirectedGraph) ShortestPath(src, dst int) (path []int, dist float64, err error) {
+	if !w.isVertexValid(src) || !w.isVertexValid(dst) {
+		return nil, 0, errors.New("vertex not found")
+	}
+	return dijkstraShortestPath(w.adjacentVertices, w.vertexCount, src, dst)
+}
+
+// ShortestPathTree runs Dijkstra's algorithm from src and returns, for every vertex,
+// its shortest distance from src and the predecessor on that shortest path.
+// Unreachable vertices have distance +Inf and predecessor -1.
+func (w *WeightedDirectedGraph) ShortestPathTree(src int) (dist []float64, prev []int) {
+	return dijkstraShortestPathTree(w.adjacentVertices, w.vertexCount, src)
+}
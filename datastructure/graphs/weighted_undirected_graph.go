@@ -0,0 +1,102 @@
+package graphs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WeightedUnDirectedGraph defines an undirected graph with weighted edges
+type WeightedUnDirectedGraph struct {
+	vertexCount      int
+	edgeCount        int
+	adjacentVertices [][]weightedEdge
+}
+
+// weightedEdge represents an edge to a neighbouring vertex with a weight
+type weightedEdge struct {
+	to     int
+	weight float64
+}
+
+// NewWeightedUnDirectedGraph initalises a new weighted undirected graph with vertexCount vertices.
+func NewWeightedUnDirectedGraph(vertexCount int) *WeightedUnDirectedGraph {
+	return &WeightedUnDirectedGraph{
+		vertexCount, 0, make([][]weightedEdge, vertexCount),
+	}
+}
+
+func (w *WeightedUnDirectedGraph) isVertexValid(vertex int) bool {
+	return vertex >= 0 && vertex < w.vertexCount
+}
+
+// GetVertexCount gets vertex count
+func (w *WeightedUnDirectedGraph) GetVertexCount() int {
+	return w.vertexCount
+}
+
+// GetEdgeCount gets the edge count
+func (w *WeightedUnDirectedGraph) GetEdgeCount() int {
+	return w.edgeCount
+}
+
+// AddWeightedEdge adds a weighted edge to the graph
+func (w *WeightedUnDirectedGraph) AddWeightedEdge(vertex1, vertex2 int, weight float64) error {
+	if w.isVertexValid(vertex1) && w.isVertexValid(vertex2) {
+		w.adjacentVertices[vertex1] = append(w.adjacentVertices[vertex1], weightedEdge{vertex2, weight})
+		w.adjacentVertices[vertex2] = append(w.adjacentVertices[vertex2], weightedEdge{vertex1, weight})
+		w.edgeCount++
+		return nil
+	}
+	return errors.New("vertex not found")
+}
+
+// GetAdjacentVertices gets all adjacent vertices for a given vertex
+func (w *WeightedUnDirectedGraph) GetAdjacentVertices(vertex int) ([]int, error) {
+	if !w.isVertexValid(vertex) {
+		return nil, errors.New("vertex not found")
+	}
+	vertices := make([]int, len(w.adjacentVertices[vertex]))
+	for i, e := range w.adjacentVertices[vertex] {
+		vertices[i] = e.to
+	}
+	return vertices, nil
+}
+
+// GetEdgeWeight gets the weight of the edge between vertex1 and vertex2.
+func (w *WeightedUnDirectedGraph) GetEdgeWeight(vertex1, vertex2 int) (float64, error) {
+	if !w.isVertexValid(vertex1) || !w.isVertexValid(vertex2) {
+		return 0, errors.New("vertex not found")
+	}
+	for _, e := range w.adjacentVertices[vertex1] {
+		if e.to == vertex2 {
+			return e.weight, nil
+		}
+	}
+	return 0, errors.New("edge not found")
+}
+
+// Print prints the graph.
+func (w *WeightedUnDirectedGraph) Print() string {
+	res := ""
+	res += fmt.Sprintf("Vertex Count: %d, Edge Count: %d\n", w.vertexCount, w.edgeCount)
+	for vertex, adjacentVertices := range w.adjacentVertices {
+		res += fmt.Sprintf("Vertex %d: %v\n", vertex, adjacentVertices)
+	}
+	return res
+}
+
+// ShortestPath returns the shortest path and distance between src and dst using Dijkstra's algorithm.
+// It returns an error if dst is unreachable from src.
+func (w *WeightedUnDirectedGraph) ShortestPath(src, dst int) (path []int, dist float64, err error) {
+	if !w.isVertexValid(src) || !w.isVertexValid(dst) {
+		return nil, 0, errors.New("vertex not found")
+	}
+	return dijkstraShortestPath(w.adjacentVertices, w.vertexCount, src, dst)
+}
+
+// ShortestPathTree runs Dijkstra's algorithm from src and returns, for every vertex,
+// its shortest distance from src and the predecessor on that shortest path.
+// Unreachable vertices have distance +Inf and predecessor -1.
+func (w *WeightedUnDirectedGraph) ShortestPathTree(src int) (dist []float64, prev []int) {
+	return dijkstraShortestPathTree(w.adjacentVertices, w.vertexCount, src)
+}